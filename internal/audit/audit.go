@@ -0,0 +1,24 @@
+// Package audit holds the append-only log of administrative actions.
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Entry is a single append-only record of an admin mutation.
+type Entry struct {
+	ID        string    `json:"id"`
+	Actor     string    `json:"actor"`  // admin user ID who performed the action
+	Action    string    `json:"action"` // e.g. "update_role", "update_balance", "delete_user"
+	Target    string    `json:"target"` // affected user ID
+	Before    string    `json:"before"` // JSON snapshot before the change
+	After     string    `json:"after"`  // JSON snapshot after the change
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Repository persists audit Entries. There is no update or delete: the log
+// is append-only.
+type Repository interface {
+	Record(ctx context.Context, e Entry) error
+}
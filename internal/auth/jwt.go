@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+)
+
+// Claims is carried by both access and refresh tokens. Scope discriminates
+// which kind a given token is, so an access token can't be replayed where a
+// refresh token is expected (or vice versa).
+type Claims struct {
+	UserID string `json:"userId"`
+	Phone  string `json:"phone"`
+	Role   string `json:"role"`
+	Scope  string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// RegistrationClaims is carried by the short-lived token minted once a
+// phone number has been proven via OTP, and accepted only by /register.
+type RegistrationClaims struct {
+	Phone string `json:"phone"`
+	Scope string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+const (
+	accessScope       = "access"
+	refreshScope      = "refresh"
+	registrationScope = "registration"
+)
+
+// TokenManager signs and verifies the JWTs used across the API.
+type TokenManager struct {
+	secret          []byte
+	AccessTTL       time.Duration
+	RefreshTTL      time.Duration
+	RegistrationTTL time.Duration
+}
+
+// NewTokenManager builds a TokenManager from a signing secret and TTLs.
+func NewTokenManager(secret string, accessTTL, refreshTTL, registrationTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:          []byte(secret),
+		AccessTTL:       accessTTL,
+		RefreshTTL:      refreshTTL,
+		RegistrationTTL: registrationTTL,
+	}
+}
+
+// SignAccessToken mints a short-lived access token for u.
+func (m *TokenManager) SignAccessToken(u *user.User) (string, error) {
+	return m.signClaims(u, m.AccessTTL, accessScope)
+}
+
+// SignRefreshToken mints a longer-lived refresh token for u.
+func (m *TokenManager) SignRefreshToken(u *user.User) (string, error) {
+	return m.signClaims(u, m.RefreshTTL, refreshScope)
+}
+
+func (m *TokenManager) signClaims(u *user.User, ttl time.Duration, scope string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: u.ID,
+		Phone:  u.Phone,
+		Role:   u.Role,
+		Scope:  scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secret)
+}
+
+func (m *TokenManager) parseScopedToken(tokenString, scope string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return m.secret, nil
+	})
+	if err != nil || !token.Valid || claims.Scope != scope {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
+
+// ParseToken validates a signed access JWT and returns its claims. It
+// rejects refresh-scoped tokens, so a refresh token can't be used as a
+// bearer credential.
+func (m *TokenManager) ParseToken(tokenString string) (*Claims, error) {
+	return m.parseScopedToken(tokenString, accessScope)
+}
+
+// ParseRefreshToken validates a signed refresh JWT and returns its claims.
+// It rejects access-scoped tokens, so an access token can't be replayed at
+// /refresh to keep renewing itself without ever presenting a refresh token.
+func (m *TokenManager) ParseRefreshToken(tokenString string) (*Claims, error) {
+	return m.parseScopedToken(tokenString, refreshScope)
+}
+
+// SignRegistrationToken mints a token proving phone was OTP-verified.
+func (m *TokenManager) SignRegistrationToken(phone string) (string, error) {
+	now := time.Now()
+	claims := RegistrationClaims{
+		Phone: phone,
+		Scope: registrationScope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.RegistrationTTL)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secret)
+}
+
+// ParseRegistrationToken validates a registration-scoped JWT.
+func (m *TokenManager) ParseRegistrationToken(tokenString string) (*RegistrationClaims, error) {
+	claims := &RegistrationClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return m.secret, nil
+	})
+	if err != nil || !token.Valid || claims.Scope != registrationScope {
+		return nil, fmt.Errorf("invalid registration token")
+	}
+	return claims, nil
+}
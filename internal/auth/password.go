@@ -0,0 +1,19 @@
+// Package auth provides password hashing and JWT issuance/verification for
+// the rest of the application.
+package auth
+
+import "golang.org/x/crypto/bcrypt"
+
+// HashPassword hashes a plaintext password for storage.
+func HashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// ComparePassword reports whether password matches the stored hash.
+func ComparePassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
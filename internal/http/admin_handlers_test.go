@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+)
+
+func TestAdminRoutesRejectNonAdminRole(t *testing.T) {
+	s := newTestServer()
+	router := NewRouter(s)
+
+	member := &user.User{Phone: "+15559990000", Role: "user"}
+	if err := s.Users.Create(context.Background(), member); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	token, err := s.Tokens.SignAccessToken(member)
+	if err != nil {
+		t.Fatalf("SignAccessToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for a non-admin token, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestAdminRoutesAllowAdminRole(t *testing.T) {
+	s := newTestServer()
+	router := NewRouter(s)
+
+	admin := &user.User{Phone: "+15559990001", Role: "admin"}
+	if err := s.Users.Create(context.Background(), admin); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	token, err := s.Tokens.SignAccessToken(admin)
+	if err != nil {
+		t.Fatalf("SignAccessToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for an admin token, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Users []user.User `json:"users"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(resp.Users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(resp.Users))
+	}
+}
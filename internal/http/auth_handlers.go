@@ -0,0 +1,156 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/auth"
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+)
+
+type registerInput struct {
+	RegistrationToken string `json:"registrationToken" validate:"required"`
+	Password          string `json:"password" validate:"required,min=8,containsany=!@#$%^&*"`
+}
+
+func (s *Server) registerHandler(w http.ResponseWriter, r *http.Request) {
+	input, err := decodeAndValidate[registerInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	// The phone must have already been proven via /auth/otp/verify
+	regClaims, err := s.Tokens.ParseRegistrationToken(input.RegistrationToken)
+	if err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_REGISTRATION_TOKEN", "invalid or expired registration token", nil)
+		return
+	}
+
+	hashedPassword, err := auth.HashPassword(input.Password)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error hashing password", nil)
+		return
+	}
+
+	u := &user.User{
+		Phone:    regClaims.Phone,
+		Password: hashedPassword,
+		Role:     "user",
+	}
+	if err := s.Users.Create(r.Context(), u); err != nil {
+		if errors.Is(err, user.ErrAlreadyExists) {
+			writeJSONError(w, http.StatusConflict, "USER_EXISTS", "user with this phone number already exists", nil)
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error saving user", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "User registered successfully",
+		"userId":  u.ID,
+	})
+}
+
+type loginInput struct {
+	Phone    string `json:"phone" validate:"required,e164"`
+	Password string `json:"password" validate:"required"`
+}
+
+func (s *Server) loginHandler(w http.ResponseWriter, r *http.Request) {
+	creds, err := decodeAndValidate[loginInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	u, err := s.Users.FindByPhone(r.Context(), creds.Phone)
+	if err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "user not found", nil)
+		return
+	}
+
+	if err := auth.ComparePassword(u.Password, creds.Password); err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "invalid password", nil)
+		return
+	}
+
+	accessToken, err := s.Tokens.SignAccessToken(u)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error issuing access token", nil)
+		return
+	}
+	refreshToken, err := s.Tokens.SignRefreshToken(u)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error issuing refresh token", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"accessToken":  accessToken,
+		"refreshToken": refreshToken,
+		"tokenType":    "Bearer",
+		"expiresIn":    int(s.Tokens.AccessTTL.Seconds()),
+	})
+}
+
+type refreshInput struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// refreshHandler exchanges a valid refresh token for a fresh access token.
+func (s *Server) refreshHandler(w http.ResponseWriter, r *http.Request) {
+	input, err := decodeAndValidate[refreshInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	claims, err := s.Tokens.ParseRefreshToken(input.RefreshToken)
+	if err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_REFRESH_TOKEN", "invalid or expired refresh token", nil)
+		return
+	}
+
+	// Re-fetch the user so a deactivated/deleted account can't keep minting tokens
+	u, err := s.Users.FindByID(r.Context(), claims.UserID)
+	if err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_REFRESH_TOKEN", "user not found", nil)
+		return
+	}
+
+	accessToken, err := s.Tokens.SignAccessToken(u)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error issuing access token", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"accessToken": accessToken,
+		"tokenType":   "Bearer",
+		"expiresIn":   int(s.Tokens.AccessTTL.Seconds()),
+	})
+}
+
+// meHandler returns the authenticated user's profile.
+func (s *Server) meHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := claimsFromContext(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", nil)
+		return
+	}
+
+	u, err := s.Users.FindByID(r.Context(), claims.UserID)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "user not found", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(u)
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/auth"
+	"github.com/MasudRana924/go-fintech-backend/internal/store/memtest"
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+)
+
+func newTestServer() *Server {
+	users := memtest.NewUserRepository()
+	return &Server{
+		Users:  users,
+		Wallet: memtest.NewWalletRepository(users),
+		Audit:  memtest.NewAuditRepository(),
+		Tokens: auth.NewTokenManager("test-secret", 15*time.Minute, 7*24*time.Hour, 10*time.Minute),
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	s := newTestServer()
+	router := NewRouter(s)
+
+	regToken, err := s.Tokens.SignRegistrationToken("+15551234567")
+	if err != nil {
+		t.Fatalf("SignRegistrationToken: %v", err)
+	}
+
+	registerBody, _ := json.Marshal(map[string]string{
+		"registrationToken": regToken,
+		"password":          "sup3r-secret!",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewReader(registerBody))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("register: expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	loginBody, _ := json.Marshal(map[string]string{
+		"phone":    "+15551234567",
+		"password": "sup3r-secret!",
+	})
+	req = httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(loginBody))
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login: expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode login response: %v", err)
+	}
+	if resp.AccessToken == "" {
+		t.Fatal("expected a non-empty access token")
+	}
+}
+
+func TestRefreshRejectsAccessToken(t *testing.T) {
+	s := newTestServer()
+	router := NewRouter(s)
+
+	accessToken, err := s.Tokens.SignAccessToken(&user.User{ID: "u1", Phone: "+15551234567", Role: "user"})
+	if err != nil {
+		t.Fatalf("SignAccessToken: %v", err)
+	}
+
+	body, _ := json.Marshal(map[string]string{"refreshToken": accessToken})
+	req := httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 refreshing with an access token, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRegisterRejectsInvalidRegistrationToken(t *testing.T) {
+	s := newTestServer()
+	router := NewRouter(s)
+
+	body, _ := json.Marshal(map[string]string{
+		"registrationToken": "not-a-real-token",
+		"password":          "sup3r-secret!",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
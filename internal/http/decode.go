@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = newValidator()
+
+// newValidator configures the validator to report JSON field names (e.g.
+// "phone") instead of Go struct field names (e.g. "Phone") in error fields.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" || name == "" {
+			return field.Name
+		}
+		return name
+	})
+	return v
+}
+
+// decodeAndValidate decodes r's JSON body into a T and runs its `validate`
+// struct tags, returning the zero value and an error (either a decode error
+// or validator.ValidationErrors) on failure.
+func decodeAndValidate[T any](r *http.Request) (T, error) {
+	var v, zero T
+	if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+		return zero, err
+	}
+	if err := validate.Struct(v); err != nil {
+		return zero, err
+	}
+	return v, nil
+}
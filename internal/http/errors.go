@@ -0,0 +1,66 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// apiError is the shape of the "error" object in every non-2xx JSON response.
+type apiError struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// writeJSONError writes a uniform {"error": {...}} envelope.
+func writeJSONError(w http.ResponseWriter, status int, code, message string, fields map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]apiError{
+		"error": {Code: code, Message: message, Fields: fields},
+	})
+}
+
+// writeDecodeError reports a malformed or failed-validation request body.
+func writeDecodeError(w http.ResponseWriter, err error) {
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		fields := make(map[string]string, len(verrs))
+		for _, fe := range verrs {
+			fields[fe.Field()] = validationMessage(fe)
+		}
+		writeJSONError(w, http.StatusBadRequest, "VALIDATION_FAILED", "request validation failed", fields)
+		return
+	}
+	writeJSONError(w, http.StatusBadRequest, "VALIDATION_FAILED", "invalid request body", nil)
+}
+
+// validationMessage turns a validator.FieldError into a human-readable hint.
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "e164":
+		return "must be E.164 (e.g. +15551234567)"
+	case "min":
+		return fmt.Sprintf("must be at least %s characters", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s characters", fe.Param())
+	case "len":
+		return fmt.Sprintf("must be exactly %s characters", fe.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fe.Param())
+	case "numeric":
+		return "must contain only digits"
+	case "containsany":
+		return fmt.Sprintf("must contain at least one of %q", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of %q", fe.Param())
+	default:
+		return fmt.Sprintf("failed %q validation", fe.Tag())
+	}
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/auth"
+)
+
+type contextKey string
+
+const claimsContextKey contextKey = "claims"
+
+// authMiddleware verifies the Authorization bearer token and injects the
+// user claims into the request context for downstream handlers.
+func (s *Server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or malformed Authorization header", nil)
+			return
+		}
+
+		claims, err := s.Tokens.ParseToken(parts[1])
+		if err != nil {
+			writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid or expired token", nil)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// claimsFromContext retrieves the claims injected by authMiddleware.
+func claimsFromContext(r *http.Request) (*auth.Claims, bool) {
+	claims, ok := r.Context().Value(claimsContextKey).(*auth.Claims)
+	return claims, ok
+}
+
+// requireRole builds a middleware that rejects requests whose claims don't
+// carry the given role. It must run after authMiddleware.
+func requireRole(role string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := claimsFromContext(r)
+			if !ok || claims.Role != role {
+				writeJSONError(w, http.StatusForbidden, "FORBIDDEN", "forbidden", nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// recoveryMiddleware turns a panicking handler into a 500 JSON response
+// instead of letting it crash the server or leak a stack trace.
+func recoveryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				log.Printf("panic handling %s %s: %v", r.Method, r.URL.Path, rec)
+				writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "internal server error", nil)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,53 @@
+// Package http wires the application's HTTP handlers and routing on top of
+// the repository and service abstractions defined elsewhere in internal/.
+package http
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/audit"
+	"github.com/MasudRana924/go-fintech-backend/internal/auth"
+	"github.com/MasudRana924/go-fintech-backend/internal/otp"
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+	"github.com/MasudRana924/go-fintech-backend/internal/wallet"
+)
+
+// Server holds the dependencies shared by all HTTP handlers.
+type Server struct {
+	Users  user.Repository
+	Wallet wallet.Repository
+	OTP    *otp.Service
+	Audit  audit.Repository
+	Tokens *auth.TokenManager
+}
+
+// NewRouter builds the application's mux.Router, wiring every route to its
+// handler and the auth middleware around protected subroutes.
+func NewRouter(s *Server) *mux.Router {
+	r := mux.NewRouter()
+	r.Use(recoveryMiddleware)
+
+	r.HandleFunc("/auth/otp/request", s.requestOTPHandler).Methods("POST")
+	r.HandleFunc("/auth/otp/verify", s.verifyOTPHandler).Methods("POST")
+	r.HandleFunc("/auth/otp/resend", s.resendOTPHandler).Methods("POST")
+	r.HandleFunc("/register", s.registerHandler).Methods("POST")
+	r.HandleFunc("/login", s.loginHandler).Methods("POST")
+	r.HandleFunc("/refresh", s.refreshHandler).Methods("POST")
+
+	protected := r.PathPrefix("").Subrouter()
+	protected.Use(s.authMiddleware)
+	protected.HandleFunc("/me", s.meHandler).Methods("GET")
+	protected.HandleFunc("/wallet/deposit", s.depositHandler).Methods("POST")
+	protected.HandleFunc("/wallet/withdraw", s.withdrawHandler).Methods("POST")
+	protected.HandleFunc("/wallet/transfer", s.transferHandler).Methods("POST")
+	protected.HandleFunc("/wallet/transactions", s.transactionsHandler).Methods("GET")
+
+	admin := protected.PathPrefix("/admin").Subrouter()
+	admin.Use(requireRole("admin"))
+	admin.HandleFunc("/users", s.listUsersHandler).Methods("GET")
+	admin.HandleFunc("/users/{id}/role", s.updateUserRoleHandler).Methods("PATCH")
+	admin.HandleFunc("/users/{id}/balance", s.updateUserBalanceHandler).Methods("PATCH")
+	admin.HandleFunc("/users/{id}", s.deleteUserHandler).Methods("DELETE")
+
+	return r
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/wallet"
+)
+
+type amountInput struct {
+	Amount float64 `json:"amount" validate:"required,gt=0"`
+}
+
+func (s *Server) depositHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := claimsFromContext(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", nil)
+		return
+	}
+
+	input, err := decodeAndValidate[amountInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	txn, err := s.Wallet.Deposit(r.Context(), claims.UserID, input.Amount)
+	if errors.Is(err, wallet.ErrUserNotFound) {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "user not found", nil)
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error processing deposit", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(txn)
+}
+
+func (s *Server) withdrawHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := claimsFromContext(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", nil)
+		return
+	}
+
+	input, err := decodeAndValidate[amountInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	txn, err := s.Wallet.Withdraw(r.Context(), claims.UserID, input.Amount)
+	if errors.Is(err, wallet.ErrInsufficientBalance) {
+		writeJSONError(w, http.StatusUnprocessableEntity, "INSUFFICIENT_BALANCE", "insufficient balance", nil)
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error processing withdrawal", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(txn)
+}
+
+type transferInput struct {
+	ReceiverPhone string  `json:"receiverPhone" validate:"required,e164"`
+	Amount        float64 `json:"amount" validate:"required,gt=0"`
+}
+
+// transferHandler moves funds from the authenticated user to another user by phone.
+func (s *Server) transferHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := claimsFromContext(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", nil)
+		return
+	}
+
+	input, err := decodeAndValidate[transferInput](r)
+	if err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+	if input.ReceiverPhone == claims.Phone {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_RECEIVER", "cannot transfer to yourself", nil)
+		return
+	}
+
+	receiver, err := s.Users.FindByPhone(r.Context(), input.ReceiverPhone)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "receiver not found", nil)
+		return
+	}
+
+	txn, err := s.Wallet.Transfer(r.Context(), claims.UserID, receiver.ID, input.Amount)
+	if errors.Is(err, wallet.ErrInsufficientBalance) {
+		writeJSONError(w, http.StatusUnprocessableEntity, "INSUFFICIENT_BALANCE", "insufficient balance", nil)
+		return
+	}
+	if errors.Is(err, wallet.ErrUserNotFound) {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "receiver not found", nil)
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error processing transfer", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(txn)
+}
+
+// transactionsHandler returns a paginated history of the authenticated user's transactions.
+func (s *Server) transactionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := claimsFromContext(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", nil)
+		return
+	}
+
+	limit := 20
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+
+	page, err := s.Wallet.ListTransactions(r.Context(), claims.UserID, limit, r.URL.Query().Get("cursor"))
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "error fetching transactions", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"transactions": page.Transactions,
+		"nextCursor":   page.NextCursor,
+	})
+}
@@ -0,0 +1,146 @@
+// Package otp implements phone verification via short-lived one-time codes.
+package otp
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	CodeLength   = 6
+	CodeTTL      = 5 * time.Minute
+	MaxAttempts  = 5
+	MinResendGap = time.Minute
+	MaxPerHour   = 5
+)
+
+// ErrRateLimited is returned when a phone number has requested too many
+// codes too quickly.
+var ErrRateLimited = errors.New("otp: rate limited")
+
+// ErrNoPendingCode is returned when verifying a phone with no outstanding code.
+var ErrNoPendingCode = errors.New("otp: no pending code")
+
+// ErrExpired is returned when the stored code has passed its TTL.
+var ErrExpired = errors.New("otp: expired")
+
+// ErrTooManyAttempts is returned once MaxAttempts incorrect guesses have been made.
+var ErrTooManyAttempts = errors.New("otp: too many attempts")
+
+// ErrIncorrectCode is returned when the supplied code doesn't match.
+var ErrIncorrectCode = errors.New("otp: incorrect code")
+
+// Code is a single outstanding OTP challenge for a phone number.
+type Code struct {
+	Phone     string
+	CodeHash  string
+	ExpiresAt time.Time
+	Attempts  int
+}
+
+// Repository persists outstanding OTP codes, keyed by phone number.
+type Repository interface {
+	Upsert(ctx context.Context, code Code) error
+	Find(ctx context.Context, phone string) (*Code, error)
+	Delete(ctx context.Context, phone string) error
+	IncrementAttempts(ctx context.Context, phone string) error
+}
+
+// RateLimiter enforces the per-phone send rate limit (1/min, 5/hour).
+type RateLimiter interface {
+	// Allow records a send attempt for phone, returning ErrRateLimited if
+	// the phone has sent too recently or too often in the last hour.
+	Allow(ctx context.Context, phone string) error
+}
+
+// Sender dispatches a one-time code to a phone number. The default
+// implementation logs it; production deployments should swap in a real SMS
+// adapter (Twilio, Vonage, ...).
+type Sender interface {
+	Send(phone, code string) error
+}
+
+// Service implements the request/verify OTP flow against a Repository,
+// RateLimiter and Sender.
+type Service struct {
+	repo    Repository
+	limiter RateLimiter
+	sender  Sender
+}
+
+// NewService builds an OTP Service.
+func NewService(repo Repository, limiter RateLimiter, sender Sender) *Service {
+	return &Service{repo: repo, limiter: limiter, sender: sender}
+}
+
+// Request generates a fresh code for phone, persists it and dispatches it
+// via the configured Sender, subject to the rate limiter.
+func (s *Service) Request(ctx context.Context, phone string) error {
+	if err := s.limiter.Allow(ctx, phone); err != nil {
+		return err
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return err
+	}
+	codeHash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Upsert(ctx, Code{
+		Phone:     phone,
+		CodeHash:  string(codeHash),
+		ExpiresAt: time.Now().Add(CodeTTL),
+		Attempts:  0,
+	}); err != nil {
+		return err
+	}
+
+	return s.sender.Send(phone, code)
+}
+
+// Verify checks code against the stored, unexpired challenge for phone and
+// consumes it (single-use) on success.
+func (s *Service) Verify(ctx context.Context, phone, code string) error {
+	stored, err := s.repo.Find(ctx, phone)
+	if err != nil {
+		return ErrNoPendingCode
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		s.repo.Delete(ctx, phone)
+		return ErrExpired
+	}
+	if stored.Attempts >= MaxAttempts {
+		s.repo.Delete(ctx, phone)
+		return ErrTooManyAttempts
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(stored.CodeHash), []byte(code)) != nil {
+		s.repo.IncrementAttempts(ctx, phone)
+		return ErrIncorrectCode
+	}
+
+	return s.repo.Delete(ctx, phone)
+}
+
+// generateCode returns a zero-padded numeric code of CodeLength digits.
+func generateCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < CodeLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", CodeLength, n.Int64()), nil
+}
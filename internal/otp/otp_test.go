@@ -0,0 +1,91 @@
+package otp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/otp"
+	"github.com/MasudRana924/go-fintech-backend/internal/store/memtest"
+)
+
+func TestVerifyRejectsAfterMaxAttempts(t *testing.T) {
+	repo := memtest.NewOTPRepository()
+	sender := memtest.NewRecordingSender()
+	svc := otp.NewService(repo, memtest.AllowAllRateLimiter{}, sender)
+	ctx := context.Background()
+
+	const phone = "+15550001111"
+	if err := svc.Request(ctx, phone); err != nil {
+		t.Fatalf("Request: %v", err)
+	}
+
+	for i := 0; i < otp.MaxAttempts; i++ {
+		if err := svc.Verify(ctx, phone, "000000"); !errors.Is(err, otp.ErrIncorrectCode) {
+			t.Fatalf("attempt %d: expected ErrIncorrectCode, got %v", i, err)
+		}
+	}
+
+	// Once MaxAttempts wrong guesses have been recorded, the next call
+	// should report too-many-attempts and consume the code, rather than
+	// another incorrect-code error.
+	if err := svc.Verify(ctx, phone, "000000"); !errors.Is(err, otp.ErrTooManyAttempts) {
+		t.Fatalf("expected ErrTooManyAttempts, got %v", err)
+	}
+
+	// The code is gone now, even with the right one.
+	code, _ := sender.LastCode(phone)
+	if err := svc.Verify(ctx, phone, code); !errors.Is(err, otp.ErrNoPendingCode) {
+		t.Fatalf("expected ErrNoPendingCode after the code was consumed, got %v", err)
+	}
+}
+
+func TestVerifyRejectsExpiredCode(t *testing.T) {
+	repo := memtest.NewOTPRepository()
+	sender := memtest.NewRecordingSender()
+	svc := otp.NewService(repo, memtest.AllowAllRateLimiter{}, sender)
+	ctx := context.Background()
+
+	const phone = "+15550002222"
+	if err := svc.Request(ctx, phone); err != nil {
+		t.Fatalf("Request: %v", err)
+	}
+
+	code, ok := sender.LastCode(phone)
+	if !ok {
+		t.Fatal("expected a code to have been sent")
+	}
+
+	stored, err := repo.Find(ctx, phone)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	stored.ExpiresAt = stored.ExpiresAt.Add(-otp.CodeTTL * 2)
+	if err := repo.Upsert(ctx, *stored); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	if err := svc.Verify(ctx, phone, code); !errors.Is(err, otp.ErrExpired) {
+		t.Fatalf("expected ErrExpired, got %v", err)
+	}
+}
+
+func TestVerifySucceedsAndIsSingleUse(t *testing.T) {
+	repo := memtest.NewOTPRepository()
+	sender := memtest.NewRecordingSender()
+	svc := otp.NewService(repo, memtest.AllowAllRateLimiter{}, sender)
+	ctx := context.Background()
+
+	const phone = "+15550003333"
+	if err := svc.Request(ctx, phone); err != nil {
+		t.Fatalf("Request: %v", err)
+	}
+	code, _ := sender.LastCode(phone)
+
+	if err := svc.Verify(ctx, phone, code); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if err := svc.Verify(ctx, phone, code); !errors.Is(err, otp.ErrNoPendingCode) {
+		t.Fatalf("expected ErrNoPendingCode on reuse, got %v", err)
+	}
+}
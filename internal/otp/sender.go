@@ -0,0 +1,30 @@
+package otp
+
+import (
+	"errors"
+	"log"
+)
+
+// LogSender is the default Sender used until a real SMS provider is wired up.
+type LogSender struct{}
+
+// Send implements Sender by logging the code to stdout.
+func (LogSender) Send(phone, code string) error {
+	log.Printf("OTP for %s: %s", phone, code)
+	return nil
+}
+
+// TwilioSender is a stub adapter showing where an SMS provider would plug in.
+type TwilioSender struct {
+	AccountSID string
+	AuthToken  string
+	FromNumber string
+}
+
+var errTwilioNotConfigured = errors.New("otp: twilio sender not configured")
+
+// Send implements Sender. It is not yet wired up to the Twilio API.
+func (t *TwilioSender) Send(phone, code string) error {
+	// TODO: call the Twilio Messages API with t.AccountSID/t.AuthToken.
+	return errTwilioNotConfigured
+}
@@ -0,0 +1,27 @@
+package memtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/audit"
+)
+
+// AuditRepository is an in-memory fake of audit.Repository.
+type AuditRepository struct {
+	mu      sync.Mutex
+	Entries []audit.Entry
+}
+
+// NewAuditRepository builds an empty in-memory AuditRepository.
+func NewAuditRepository() *AuditRepository {
+	return &AuditRepository{}
+}
+
+// Record implements audit.Repository.
+func (r *AuditRepository) Record(ctx context.Context, e audit.Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Entries = append(r.Entries, e)
+	return nil
+}
@@ -0,0 +1,246 @@
+// Package memtest provides in-memory fakes of the repository interfaces for
+// use in handler-level tests, so they don't need a live MongoDB instance.
+package memtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+	"github.com/MasudRana924/go-fintech-backend/internal/wallet"
+)
+
+// UserRepository is an in-memory fake of user.Repository.
+type UserRepository struct {
+	mu     sync.Mutex
+	nextID int
+	users  map[string]*user.User // keyed by ID
+}
+
+// NewUserRepository builds an empty in-memory UserRepository.
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: make(map[string]*user.User)}
+}
+
+// FindByPhone implements user.Repository.
+func (r *UserRepository) FindByPhone(ctx context.Context, phone string) (*user.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Phone == phone {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+// FindByID implements user.Repository.
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.ErrNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+// Create implements user.Repository.
+func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.users {
+		if existing.Phone == u.Phone {
+			return user.ErrAlreadyExists
+		}
+	}
+	r.nextID++
+	id := fmt.Sprintf("%024d", r.nextID)
+	cp := *u
+	cp.ID = id
+	r.users[id] = &cp
+	u.ID = id
+	return nil
+}
+
+// UpdateBalance implements user.Repository.
+func (r *UserRepository) UpdateBalance(ctx context.Context, id string, delta float64) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return 0, user.ErrNotFound
+	}
+	if u.Balance+delta < 0 {
+		return 0, user.ErrInsufficientBalance
+	}
+	u.Balance += delta
+	return u.Balance, nil
+}
+
+// List implements user.Repository. The cursor is simply the last ID seen.
+func (r *UserRepository) List(ctx context.Context, f user.ListFilter, limit int, cursor string) (user.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]string, 0, len(r.users))
+	for id := range r.users {
+		ids = append(ids, id)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+
+	var page user.Page
+	for _, id := range ids {
+		if cursor != "" && id >= cursor {
+			continue
+		}
+		u := r.users[id]
+		if f.Role != "" && u.Role != f.Role {
+			continue
+		}
+		if f.MinBalance != nil && u.Balance < *f.MinBalance {
+			continue
+		}
+		if f.MaxBalance != nil && u.Balance > *f.MaxBalance {
+			continue
+		}
+		page.Users = append(page.Users, *u)
+		if len(page.Users) == limit {
+			page.NextCursor = id
+			break
+		}
+	}
+	return page, nil
+}
+
+// UpdateRole implements user.Repository.
+func (r *UserRepository) UpdateRole(ctx context.Context, id string, role string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+	u.Role = role
+	return nil
+}
+
+// Delete implements user.Repository.
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[id]; !ok {
+		return user.ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// ExistsWithRole implements user.Repository.
+func (r *UserRepository) ExistsWithRole(ctx context.Context, role string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Role == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// WalletRepository is an in-memory fake of wallet.Repository. It mutates
+// balances through the same UserRepository a handler's Users field points
+// at, so tests can delete a user mid-scenario and observe the same
+// missing-user handling the Mongo-backed WalletRepository applies.
+type WalletRepository struct {
+	mu     sync.Mutex
+	users  *UserRepository
+	nextID int
+	txns   []wallet.Transaction
+}
+
+// NewWalletRepository builds an empty in-memory WalletRepository backed by users.
+func NewWalletRepository(users *UserRepository) *WalletRepository {
+	return &WalletRepository{users: users}
+}
+
+// Deposit implements wallet.Repository.
+func (r *WalletRepository) Deposit(ctx context.Context, userID string, amount float64) (*wallet.Transaction, error) {
+	if _, err := r.users.UpdateBalance(ctx, userID, amount); err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return nil, wallet.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return r.record(wallet.Transaction{To: userID, Amount: amount, Type: wallet.TypeDeposit, Status: wallet.StatusCompleted}), nil
+}
+
+// Withdraw implements wallet.Repository.
+func (r *WalletRepository) Withdraw(ctx context.Context, userID string, amount float64) (*wallet.Transaction, error) {
+	if _, err := r.users.UpdateBalance(ctx, userID, -amount); err != nil {
+		if errors.Is(err, user.ErrNotFound) || errors.Is(err, user.ErrInsufficientBalance) {
+			return nil, wallet.ErrInsufficientBalance
+		}
+		return nil, err
+	}
+	return r.record(wallet.Transaction{From: userID, Amount: amount, Type: wallet.TypeWithdraw, Status: wallet.StatusCompleted}), nil
+}
+
+// Transfer implements wallet.Repository. The debit is rolled back if the
+// credit side fails, mirroring the all-or-nothing guarantee the Mongo
+// implementation gets from a session transaction.
+func (r *WalletRepository) Transfer(ctx context.Context, fromID, toID string, amount float64) (*wallet.Transaction, error) {
+	if _, err := r.users.UpdateBalance(ctx, fromID, -amount); err != nil {
+		if errors.Is(err, user.ErrNotFound) || errors.Is(err, user.ErrInsufficientBalance) {
+			return nil, wallet.ErrInsufficientBalance
+		}
+		return nil, err
+	}
+
+	if _, err := r.users.UpdateBalance(ctx, toID, amount); err != nil {
+		r.users.UpdateBalance(ctx, fromID, amount) // best-effort rollback of the debit
+		if errors.Is(err, user.ErrNotFound) {
+			return nil, wallet.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return r.record(wallet.Transaction{From: fromID, To: toID, Amount: amount, Type: wallet.TypeTransfer, Status: wallet.StatusCompleted}), nil
+}
+
+// ListTransactions implements wallet.Repository. The cursor is simply the last ID seen.
+func (r *WalletRepository) ListTransactions(ctx context.Context, userID string, limit int, cursor string) (wallet.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var page wallet.Page
+	for i := len(r.txns) - 1; i >= 0; i-- {
+		txn := r.txns[i]
+		if txn.From != userID && txn.To != userID {
+			continue
+		}
+		if cursor != "" && txn.ID >= cursor {
+			continue
+		}
+		page.Transactions = append(page.Transactions, txn)
+		if len(page.Transactions) == limit {
+			page.NextCursor = txn.ID
+			break
+		}
+	}
+	return page, nil
+}
+
+func (r *WalletRepository) record(txn wallet.Transaction) *wallet.Transaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	txn.ID = fmt.Sprintf("%024d", r.nextID)
+	r.txns = append(r.txns, txn)
+	return &txn
+}
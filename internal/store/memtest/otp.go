@@ -0,0 +1,97 @@
+package memtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/otp"
+)
+
+// OTPRepository is an in-memory fake of otp.Repository.
+type OTPRepository struct {
+	mu    sync.Mutex
+	codes map[string]otp.Code // keyed by phone
+}
+
+// NewOTPRepository builds an empty in-memory OTPRepository.
+func NewOTPRepository() *OTPRepository {
+	return &OTPRepository{codes: make(map[string]otp.Code)}
+}
+
+// Upsert implements otp.Repository.
+func (r *OTPRepository) Upsert(ctx context.Context, code otp.Code) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codes[code.Phone] = code
+	return nil
+}
+
+// Find implements otp.Repository.
+func (r *OTPRepository) Find(ctx context.Context, phone string) (*otp.Code, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	code, ok := r.codes[phone]
+	if !ok {
+		return nil, otp.ErrNoPendingCode
+	}
+	cp := code
+	return &cp, nil
+}
+
+// Delete implements otp.Repository.
+func (r *OTPRepository) Delete(ctx context.Context, phone string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.codes, phone)
+	return nil
+}
+
+// IncrementAttempts implements otp.Repository.
+func (r *OTPRepository) IncrementAttempts(ctx context.Context, phone string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	code, ok := r.codes[phone]
+	if !ok {
+		return nil
+	}
+	code.Attempts++
+	r.codes[phone] = code
+	return nil
+}
+
+// AllowAllRateLimiter is an in-memory fake of otp.RateLimiter that never
+// rejects a send, for tests that aren't exercising the rate limit itself.
+type AllowAllRateLimiter struct{}
+
+// Allow implements otp.RateLimiter.
+func (AllowAllRateLimiter) Allow(ctx context.Context, phone string) error {
+	return nil
+}
+
+// RecordingSender is an in-memory fake of otp.Sender that remembers the last
+// code sent per phone number, so tests can verify it without reading stdout.
+type RecordingSender struct {
+	mu   sync.Mutex
+	sent map[string]string // phone -> code
+}
+
+// NewRecordingSender builds an empty RecordingSender.
+func NewRecordingSender() *RecordingSender {
+	return &RecordingSender{sent: make(map[string]string)}
+}
+
+// Send implements otp.Sender.
+func (s *RecordingSender) Send(phone, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent[phone] = code
+	return nil
+}
+
+// LastCode returns the most recent code sent to phone, if any.
+func (s *RecordingSender) LastCode(phone string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code, ok := s.sent[phone]
+	return code, ok
+}
@@ -0,0 +1,77 @@
+package memtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+	"github.com/MasudRana924/go-fintech-backend/internal/wallet"
+)
+
+func TestWalletWithdrawRejectsInsufficientBalance(t *testing.T) {
+	users := NewUserRepository()
+	w := NewWalletRepository(users)
+	ctx := context.Background()
+
+	u := &user.User{Phone: "+15550000001", Balance: 10}
+	if err := users.Create(ctx, u); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := w.Withdraw(ctx, u.ID, 20); !errors.Is(err, wallet.ErrInsufficientBalance) {
+		t.Fatalf("expected ErrInsufficientBalance, got %v", err)
+	}
+	got, _ := users.FindByID(ctx, u.ID)
+	if got.Balance != 10 {
+		t.Fatalf("balance should be unchanged after a rejected withdrawal, got %v", got.Balance)
+	}
+}
+
+func TestWalletDepositToDeletedUserIsRejected(t *testing.T) {
+	users := NewUserRepository()
+	w := NewWalletRepository(users)
+	ctx := context.Background()
+
+	u := &user.User{Phone: "+15550000002"}
+	if err := users.Create(ctx, u); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := users.Delete(ctx, u.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := w.Deposit(ctx, u.ID, 50); !errors.Is(err, wallet.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestWalletTransferToDeletedReceiverRollsBackTheDebit(t *testing.T) {
+	users := NewUserRepository()
+	w := NewWalletRepository(users)
+	ctx := context.Background()
+
+	sender := &user.User{Phone: "+15550000003", Balance: 100}
+	receiver := &user.User{Phone: "+15550000004"}
+	if err := users.Create(ctx, sender); err != nil {
+		t.Fatalf("Create sender: %v", err)
+	}
+	if err := users.Create(ctx, receiver); err != nil {
+		t.Fatalf("Create receiver: %v", err)
+	}
+
+	// Simulate the receiver being deleted (e.g. via DELETE /admin/users/{id})
+	// in the window between the handler's lookup and the transfer executing.
+	if err := users.Delete(ctx, receiver.ID); err != nil {
+		t.Fatalf("Delete receiver: %v", err)
+	}
+
+	if _, err := w.Transfer(ctx, sender.ID, receiver.ID, 40); !errors.Is(err, wallet.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	got, _ := users.FindByID(ctx, sender.ID)
+	if got.Balance != 100 {
+		t.Fatalf("sender's debit should have been rolled back, got balance %v", got.Balance)
+	}
+}
@@ -0,0 +1,46 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/audit"
+)
+
+type auditDoc struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Actor     string             `bson:"actor"`
+	Action    string             `bson:"action"`
+	Target    string             `bson:"target"`
+	Before    string             `bson:"before,omitempty"`
+	After     string             `bson:"after,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// AuditRepository is the MongoDB-backed implementation of audit.Repository,
+// appending to the "admin_audit" collection.
+type AuditRepository struct {
+	coll *mongo.Collection
+}
+
+// NewAuditRepository builds an AuditRepository backed by db's "admin_audit" collection.
+func NewAuditRepository(db *mongo.Database) *AuditRepository {
+	return &AuditRepository{coll: db.Collection("admin_audit")}
+}
+
+// Record implements audit.Repository.
+func (r *AuditRepository) Record(ctx context.Context, e audit.Entry) error {
+	_, err := r.coll.InsertOne(ctx, auditDoc{
+		ID:        primitive.NewObjectID(),
+		Actor:     e.Actor,
+		Action:    e.Action,
+		Target:    e.Target,
+		Before:    e.Before,
+		After:     e.After,
+		CreatedAt: e.CreatedAt,
+	})
+	return err
+}
@@ -0,0 +1,147 @@
+package mongo
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/otp"
+)
+
+type otpCodeDoc struct {
+	Phone     string    `bson:"phone"`
+	CodeHash  string    `bson:"codeHash"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+	Attempts  int       `bson:"attempts"`
+}
+
+// OTPRepository is the MongoDB-backed implementation of otp.Repository.
+type OTPRepository struct {
+	coll *mongo.Collection
+}
+
+// NewOTPRepository builds an OTPRepository backed by db's "otp_codes"
+// collection, creating a TTL index so expired codes are reaped automatically.
+func NewOTPRepository(db *mongo.Database) *OTPRepository {
+	r := &OTPRepository{coll: db.Collection("otp_codes")}
+	_, err := r.coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.M{"expiresAt": 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		log.Printf("warning: could not create otp_codes TTL index: %v", err)
+	}
+	return r
+}
+
+// Upsert implements otp.Repository.
+func (r *OTPRepository) Upsert(ctx context.Context, code otp.Code) error {
+	_, err := r.coll.ReplaceOne(ctx, bson.M{"phone": code.Phone}, otpCodeDoc{
+		Phone:     code.Phone,
+		CodeHash:  code.CodeHash,
+		ExpiresAt: code.ExpiresAt,
+		Attempts:  code.Attempts,
+	}, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Find implements otp.Repository.
+func (r *OTPRepository) Find(ctx context.Context, phone string) (*otp.Code, error) {
+	var doc otpCodeDoc
+	if err := r.coll.FindOne(ctx, bson.M{"phone": phone}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &otp.Code{
+		Phone:     doc.Phone,
+		CodeHash:  doc.CodeHash,
+		ExpiresAt: doc.ExpiresAt,
+		Attempts:  doc.Attempts,
+	}, nil
+}
+
+// Delete implements otp.Repository.
+func (r *OTPRepository) Delete(ctx context.Context, phone string) error {
+	_, err := r.coll.DeleteOne(ctx, bson.M{"phone": phone})
+	return err
+}
+
+// IncrementAttempts implements otp.Repository.
+func (r *OTPRepository) IncrementAttempts(ctx context.Context, phone string) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"phone": phone}, bson.M{"$inc": bson.M{"attempts": 1}})
+	return err
+}
+
+// OTPRateLimiter is the MongoDB-backed implementation of otp.RateLimiter.
+type OTPRateLimiter struct {
+	coll *mongo.Collection
+}
+
+// NewOTPRateLimiter builds an OTPRateLimiter backed by db's
+// "otp_rate_limits" collection, creating a unique index on phone so at most
+// one rate-limit document exists per phone (Allow relies on this to make
+// the hourly-window rollover atomic, see below).
+func NewOTPRateLimiter(db *mongo.Database) *OTPRateLimiter {
+	r := &OTPRateLimiter{coll: db.Collection("otp_rate_limits")}
+	_, err := r.coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.M{"phone": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Printf("warning: could not create otp_rate_limits unique index: %v", err)
+	}
+	return r
+}
+
+// Allow implements otp.RateLimiter, permitting at most one send per minute
+// and otp.MaxPerHour sends per hourly window, per phone number. A window is
+// fixed: it opens at the first send after the previous window has expired
+// and lasts one hour from then.
+//
+// Both checks are enforced with a single atomic FindOneAndUpdate per
+// attempt (the same filter-guard pattern UserRepository.UpdateBalance uses
+// for balance floors) rather than a find-then-replace, so concurrent
+// requests for the same phone can't all read the same CountInHour and all
+// pass the cap.
+func (r *OTPRateLimiter) Allow(ctx context.Context, phone string) error {
+	now := time.Now()
+
+	// Record a send against the current window, if one is open and under cap.
+	filter := bson.M{
+		"phone":       phone,
+		"lastSentAt":  bson.M{"$lte": now.Add(-otp.MinResendGap)},
+		"hourStarted": bson.M{"$gt": now.Add(-time.Hour)},
+		"countInHour": bson.M{"$lt": otp.MaxPerHour},
+	}
+	update := bson.M{"$set": bson.M{"lastSentAt": now}, "$inc": bson.M{"countInHour": 1}}
+	if res := r.coll.FindOneAndUpdate(ctx, filter, update); res.Err() == nil {
+		return nil
+	} else if res.Err() != mongo.ErrNoDocuments {
+		return res.Err()
+	}
+
+	// No open-and-under-cap window matched: either this phone has never
+	// sent before, or its window expired an hour ago (both of which should
+	// start a fresh window, unless the last send was under a minute ago),
+	// or it's already at its cap or sent under a minute ago (which should
+	// be rejected). The unique phone index turns the rejected cases into a
+	// duplicate-key error on the upsert below, since an existing document
+	// for this phone fails to match the filter.
+	filter = bson.M{
+		"phone":       phone,
+		"hourStarted": bson.M{"$lte": now.Add(-time.Hour)},
+		"lastSentAt":  bson.M{"$lte": now.Add(-otp.MinResendGap)},
+	}
+	update = bson.M{"$set": bson.M{"lastSentAt": now, "hourStarted": now, "countInHour": 1}}
+	res := r.coll.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetUpsert(true))
+	if err := res.Err(); err != nil && err != mongo.ErrNoDocuments {
+		if mongo.IsDuplicateKeyError(err) {
+			return otp.ErrRateLimited
+		}
+		return err
+	}
+	return nil
+}
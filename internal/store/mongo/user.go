@@ -0,0 +1,248 @@
+// Package mongo provides MongoDB-backed implementations of the repository
+// interfaces declared in internal/user, internal/wallet and internal/otp.
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/user"
+)
+
+// userDoc is the BSON shape stored in the users collection.
+type userDoc struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Phone      string             `bson:"phone"`
+	Password   string             `bson:"password"`
+	FirstName  string             `bson:"firstName"`
+	LastName   string             `bson:"lastName"`
+	AvatarLogo string             `bson:"avatarLogo,omitempty"`
+	Amount     float64            `bson:"amount"`
+	Balance    float64            `bson:"balance"`
+	Point      int                `bson:"point"`
+	Role       string             `bson:"role"`
+}
+
+func (d userDoc) toDomain() *user.User {
+	return &user.User{
+		ID:         d.ID.Hex(),
+		Phone:      d.Phone,
+		Password:   d.Password,
+		FirstName:  d.FirstName,
+		LastName:   d.LastName,
+		AvatarLogo: d.AvatarLogo,
+		Amount:     d.Amount,
+		Balance:    d.Balance,
+		Point:      d.Point,
+		Role:       d.Role,
+		CreatedAt:  d.ID.Timestamp(),
+	}
+}
+
+// UserRepository is the MongoDB-backed implementation of user.Repository.
+type UserRepository struct {
+	coll *mongo.Collection
+}
+
+// NewUserRepository builds a UserRepository backed by db's "users"
+// collection, creating a unique index on phone so at most one user document
+// can exist per phone number (Create relies on this to make concurrent
+// registrations for the same phone mutually exclusive).
+func NewUserRepository(db *mongo.Database) *UserRepository {
+	r := &UserRepository{coll: db.Collection("users")}
+	_, err := r.coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.M{"phone": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Printf("warning: could not create users unique phone index: %v", err)
+	}
+	return r
+}
+
+// FindByPhone implements user.Repository.
+func (r *UserRepository) FindByPhone(ctx context.Context, phone string) (*user.User, error) {
+	var doc userDoc
+	if err := r.coll.FindOne(ctx, bson.M{"phone": phone}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, user.ErrNotFound
+		}
+		return nil, err
+	}
+	return doc.toDomain(), nil
+}
+
+// FindByID implements user.Repository.
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, user.ErrNotFound
+	}
+	var doc userDoc
+	if err := r.coll.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, user.ErrNotFound
+		}
+		return nil, err
+	}
+	return doc.toDomain(), nil
+}
+
+// Create implements user.Repository. It relies on the unique index on
+// phone (see NewUserRepository) rather than a find-then-insert check, so
+// two concurrent registrations for the same phone can't both succeed.
+func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
+	oid := primitive.NewObjectID()
+	doc := userDoc{
+		ID:         oid,
+		Phone:      u.Phone,
+		Password:   u.Password,
+		FirstName:  u.FirstName,
+		LastName:   u.LastName,
+		AvatarLogo: u.AvatarLogo,
+		Amount:     u.Amount,
+		Balance:    u.Balance,
+		Point:      u.Point,
+		Role:       u.Role,
+	}
+	if _, err := r.coll.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return user.ErrAlreadyExists
+		}
+		return err
+	}
+	u.ID = oid.Hex()
+	return nil
+}
+
+// UpdateBalance implements user.Repository.
+func (r *UserRepository) UpdateBalance(ctx context.Context, id string, delta float64) (float64, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, user.ErrNotFound
+	}
+
+	filter := bson.M{"_id": oid}
+	if delta < 0 {
+		filter["balance"] = bson.M{"$gte": -delta}
+	}
+
+	var doc userDoc
+	err = r.coll.FindOneAndUpdate(ctx, filter, bson.M{"$inc": bson.M{"balance": delta}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if delta < 0 {
+				return 0, user.ErrInsufficientBalance
+			}
+			return 0, user.ErrNotFound
+		}
+		return 0, err
+	}
+	return doc.Balance, nil
+}
+
+// List implements user.Repository.
+func (r *UserRepository) List(ctx context.Context, f user.ListFilter, limit int, cursor string) (user.Page, error) {
+	filter := bson.M{}
+	if f.Role != "" {
+		filter["role"] = f.Role
+	}
+	if f.MinBalance != nil || f.MaxBalance != nil {
+		balance := bson.M{}
+		if f.MinBalance != nil {
+			balance["$gte"] = *f.MinBalance
+		}
+		if f.MaxBalance != nil {
+			balance["$lte"] = *f.MaxBalance
+		}
+		filter["balance"] = balance
+	}
+	if cursor != "" {
+		cursorID, err := decodeUserCursor(cursor)
+		if err != nil {
+			return user.Page{}, err
+		}
+		filter["_id"] = bson.M{"$lt": cursorID}
+	}
+
+	findOpts := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, findOpts)
+	if err != nil {
+		return user.Page{}, err
+	}
+	defer cur.Close(ctx)
+
+	var docs []userDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return user.Page{}, err
+	}
+
+	page := user.Page{Users: make([]user.User, len(docs))}
+	for i, d := range docs {
+		page.Users[i] = *d.toDomain()
+	}
+	if len(docs) == limit {
+		page.NextCursor = encodeUserCursor(docs[len(docs)-1].ID)
+	}
+	return page, nil
+}
+
+// UpdateRole implements user.Repository.
+func (r *UserRepository) UpdateRole(ctx context.Context, id string, role string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return user.ErrNotFound
+	}
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{"role": role}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
+
+// Delete implements user.Repository.
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return user.ErrNotFound
+	}
+	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
+
+// ExistsWithRole implements user.Repository.
+func (r *UserRepository) ExistsWithRole(ctx context.Context, role string) (bool, error) {
+	count, err := r.coll.CountDocuments(ctx, bson.M{"role": role}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func encodeUserCursor(id primitive.ObjectID) string {
+	return base64.URLEncoding.EncodeToString([]byte(id.Hex()))
+}
+
+func decodeUserCursor(cursor string) (primitive.ObjectID, error) {
+	decoded, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return primitive.ObjectIDFromHex(string(decoded))
+}
@@ -0,0 +1,249 @@
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/MasudRana924/go-fintech-backend/internal/wallet"
+)
+
+// txnDoc is the BSON shape stored in the transactions collection.
+type txnDoc struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	From      primitive.ObjectID `bson:"from,omitempty"`
+	To        primitive.ObjectID `bson:"to,omitempty"`
+	Amount    float64            `bson:"amount"`
+	Type      string             `bson:"type"`
+	Status    string             `bson:"status"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+func (d txnDoc) toDomain() wallet.Transaction {
+	return wallet.Transaction{
+		ID:        d.ID.Hex(),
+		From:      d.From.Hex(),
+		To:        d.To.Hex(),
+		Amount:    d.Amount,
+		Type:      d.Type,
+		Status:    d.Status,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// WalletRepository is the MongoDB-backed implementation of wallet.Repository.
+type WalletRepository struct {
+	client *mongo.Client
+	users  *mongo.Collection
+	txns   *mongo.Collection
+}
+
+// NewWalletRepository builds a WalletRepository backed by db's "users" and
+// "transactions" collections.
+func NewWalletRepository(client *mongo.Client, db *mongo.Database) *WalletRepository {
+	return &WalletRepository{
+		client: client,
+		users:  db.Collection("users"),
+		txns:   db.Collection("transactions"),
+	}
+}
+
+// Deposit implements wallet.Repository.
+func (r *WalletRepository) Deposit(ctx context.Context, userID string, amount float64) (*wallet.Transaction, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := txnDoc{
+		ID:        primitive.NewObjectID(),
+		To:        oid,
+		Amount:    amount,
+		Type:      wallet.TypeDeposit,
+		Status:    wallet.StatusCompleted,
+		CreatedAt: time.Now(),
+	}
+
+	err = r.withTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := r.credit(sessCtx, oid, amount); err != nil {
+			return err
+		}
+		_, err := r.txns.InsertOne(sessCtx, doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	txn := doc.toDomain()
+	return &txn, nil
+}
+
+// Withdraw implements wallet.Repository.
+func (r *WalletRepository) Withdraw(ctx context.Context, userID string, amount float64) (*wallet.Transaction, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := txnDoc{
+		ID:        primitive.NewObjectID(),
+		From:      oid,
+		Amount:    amount,
+		Type:      wallet.TypeWithdraw,
+		Status:    wallet.StatusCompleted,
+		CreatedAt: time.Now(),
+	}
+
+	err = r.withTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := r.debit(sessCtx, oid, amount); err != nil {
+			return err
+		}
+		_, err := r.txns.InsertOne(sessCtx, doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	txn := doc.toDomain()
+	return &txn, nil
+}
+
+// Transfer implements wallet.Repository.
+func (r *WalletRepository) Transfer(ctx context.Context, fromID, toID string, amount float64) (*wallet.Transaction, error) {
+	fromOID, err := primitive.ObjectIDFromHex(fromID)
+	if err != nil {
+		return nil, err
+	}
+	toOID, err := primitive.ObjectIDFromHex(toID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := txnDoc{
+		ID:        primitive.NewObjectID(),
+		From:      fromOID,
+		To:        toOID,
+		Amount:    amount,
+		Type:      wallet.TypeTransfer,
+		Status:    wallet.StatusCompleted,
+		CreatedAt: time.Now(),
+	}
+
+	err = r.withTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if err := r.debit(sessCtx, fromOID, amount); err != nil {
+			return err
+		}
+		if err := r.credit(sessCtx, toOID, amount); err != nil {
+			return err
+		}
+		_, err := r.txns.InsertOne(sessCtx, doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	txn := doc.toDomain()
+	return &txn, nil
+}
+
+// ListTransactions implements wallet.Repository.
+func (r *WalletRepository) ListTransactions(ctx context.Context, userID string, limit int, cursor string) (wallet.Page, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return wallet.Page{}, err
+	}
+
+	filter := bson.M{"$or": []bson.M{{"from": oid}, {"to": oid}}}
+	if cursor != "" {
+		cursorID, err := decodeCursor(cursor)
+		if err != nil {
+			return wallet.Page{}, err
+		}
+		filter["_id"] = bson.M{"$lt": cursorID}
+	}
+
+	findOpts := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(int64(limit))
+	cur, err := r.txns.Find(ctx, filter, findOpts)
+	if err != nil {
+		return wallet.Page{}, err
+	}
+	defer cur.Close(ctx)
+
+	var docs []txnDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return wallet.Page{}, err
+	}
+
+	page := wallet.Page{Transactions: make([]wallet.Transaction, len(docs))}
+	for i, d := range docs {
+		page.Transactions[i] = d.toDomain()
+	}
+	if len(docs) == limit {
+		page.NextCursor = encodeCursor(docs[len(docs)-1].ID)
+	}
+	return page, nil
+}
+
+// withTransaction runs fn inside a MongoDB session transaction.
+func (r *WalletRepository) withTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := r.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+// debit atomically decrements a user's balance, refusing to drive it negative.
+func (r *WalletRepository) debit(sessCtx mongo.SessionContext, userID primitive.ObjectID, amount float64) error {
+	res := r.users.FindOneAndUpdate(
+		sessCtx,
+		bson.M{"_id": userID, "balance": bson.M{"$gte": amount}},
+		bson.M{"$inc": bson.M{"balance": -amount}},
+	)
+	if res.Err() != nil {
+		if res.Err() == mongo.ErrNoDocuments {
+			return wallet.ErrInsufficientBalance
+		}
+		return res.Err()
+	}
+	return nil
+}
+
+// credit atomically increments a user's balance, failing with
+// wallet.ErrUserNotFound if the user doc is gone by the time the
+// transaction runs (e.g. deleted via DELETE /admin/users/{id} in the
+// window between the handler's lookup and this executing) so the caller
+// aborts rather than silently recording a transaction with no matching
+// credit.
+func (r *WalletRepository) credit(sessCtx mongo.SessionContext, userID primitive.ObjectID, amount float64) error {
+	res, err := r.users.UpdateOne(sessCtx, bson.M{"_id": userID}, bson.M{"$inc": bson.M{"balance": amount}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return wallet.ErrUserNotFound
+	}
+	return nil
+}
+
+func encodeCursor(id primitive.ObjectID) string {
+	return base64.URLEncoding.EncodeToString([]byte(id.Hex()))
+}
+
+func decodeCursor(cursor string) (primitive.ObjectID, error) {
+	decoded, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return primitive.ObjectIDFromHex(string(decoded))
+}
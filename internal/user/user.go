@@ -0,0 +1,66 @@
+// Package user holds the User domain type and the repository abstraction
+// used to persist it, independent of any particular storage engine.
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned by Repository methods when no matching user exists.
+var ErrNotFound = errors.New("user: not found")
+
+// ErrAlreadyExists is returned by Create when the phone number is taken.
+var ErrAlreadyExists = errors.New("user: already exists")
+
+// ErrInsufficientBalance is returned when a debit would drive Balance negative.
+var ErrInsufficientBalance = errors.New("user: insufficient balance")
+
+// User represents a user account.
+type User struct {
+	ID         string    `json:"id"`
+	Phone      string    `json:"phone"`
+	Password   string    `json:"-"`
+	FirstName  string    `json:"firstName"`
+	LastName   string    `json:"lastName"`
+	AvatarLogo string    `json:"avatarLogo,omitempty"`
+	Amount     float64   `json:"amount"`
+	Balance    float64   `json:"balance"`
+	Point      int       `json:"point"`
+	Role       string    `json:"role"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// ListFilter narrows List to users matching the given role and/or balance range.
+// Zero values mean "no constraint" for that field.
+type ListFilter struct {
+	Role       string
+	MinBalance *float64
+	MaxBalance *float64
+}
+
+// Page is a cursor-paginated slice of users.
+type Page struct {
+	Users      []User
+	NextCursor string
+}
+
+// Repository abstracts persistence of User records.
+type Repository interface {
+	FindByPhone(ctx context.Context, phone string) (*User, error)
+	FindByID(ctx context.Context, id string) (*User, error)
+	Create(ctx context.Context, u *User) error
+	// UpdateBalance atomically applies delta to the user's balance, failing
+	// with ErrInsufficientBalance if that would drive it negative. It
+	// returns the balance after the update so callers (e.g. audit logging)
+	// don't have to recompute it client-side and risk it drifting from
+	// what's actually stored under concurrent updates.
+	UpdateBalance(ctx context.Context, id string, delta float64) (float64, error)
+	// List returns up to limit users matching filter, ordered newest first.
+	List(ctx context.Context, filter ListFilter, limit int, cursor string) (Page, error)
+	UpdateRole(ctx context.Context, id string, role string) error
+	Delete(ctx context.Context, id string) error
+	// ExistsWithRole reports whether any user currently has the given role.
+	ExistsWithRole(ctx context.Context, role string) (bool, error)
+}
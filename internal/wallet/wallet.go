@@ -0,0 +1,52 @@
+// Package wallet holds the transaction domain type and the repository
+// abstraction for moving funds between users.
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Transaction types.
+const (
+	TypeDeposit  = "deposit"
+	TypeWithdraw = "withdraw"
+	TypeTransfer = "transfer"
+
+	StatusCompleted = "completed"
+)
+
+// ErrInsufficientBalance is returned when a withdrawal or transfer would
+// drive the sender's balance negative.
+var ErrInsufficientBalance = errors.New("wallet: insufficient balance")
+
+// ErrUserNotFound is returned when a deposit or transfer targets a user ID
+// that no longer exists, so the credit side of the transaction has nothing
+// to apply to.
+var ErrUserNotFound = errors.New("wallet: user not found")
+
+// Transaction records a single movement of funds.
+type Transaction struct {
+	ID        string    `json:"id"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Amount    float64   `json:"amount"`
+	Type      string    `json:"type"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Page is a cursor-paginated slice of transactions.
+type Page struct {
+	Transactions []Transaction
+	NextCursor   string
+}
+
+// Repository performs the atomic balance mutations backing the wallet API.
+type Repository interface {
+	Deposit(ctx context.Context, userID string, amount float64) (*Transaction, error)
+	Withdraw(ctx context.Context, userID string, amount float64) (*Transaction, error)
+	Transfer(ctx context.Context, fromID, toID string, amount float64) (*Transaction, error)
+	ListTransactions(ctx context.Context, userID string, limit int, cursor string) (Page, error)
+}